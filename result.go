@@ -1,43 +1,159 @@
 package result
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"time"
 )
 
 type mFunc[T any, U any] func(T) U
 
-// Result is a container type that holds a value of type T or an error.
-// It cannot simultaneously hold a non-zero value and a non-nil error.
+// Result is a container type that holds a value of type T or an error of
+// type E. It cannot simultaneously hold a non-zero value and a non-nil error.
 //
 // Nevertheless, when working with Result, if it holds both an error and a value,
 // it will always return a zero value of type T.
 // In such cases, for example, calling Value() will return a zero value of type T,
 // and calling Match() will always return result.IsErr().
-type Result[T any, U any] struct {
-	value *T
-	err   error
+type Result[T any, E any] struct {
+	value  *T
+	err    E
+	hasErr bool
+	strict bool
 }
 
-// Ok returns a new instance of Result[T, any] that holds a value, where T is
-// the inherited type of value.
+// C configures the low-level construction of a Result, as well as package-wide
+// behavior such as the JSON object keys used by MarshalJSON/UnmarshalJSON.
+type C struct {
+	// Strict makes Unwrap panic if the Result was built holding both a
+	// non-zero value and a non-zero error at the same time, enforcing the
+	// invariant described on Result instead of silently tolerating it.
+	Strict bool
+
+	// OkKey and ErrKey name the JSON object key used for, respectively, the
+	// value and the error when marshaling/unmarshaling a Result. They default
+	// to "ok" and "err" when left empty.
+	OkKey  string
+	ErrKey string
+}
+
+// JSONConfig is the package-level C consulted by MarshalJSON/UnmarshalJSON
+// for the object key names. Only OkKey/ErrKey are read from it; Strict has no
+// effect here.
+var JSONConfig = C{OkKey: "ok", ErrKey: "err"}
+
+func jsonKeys() (okKey, errKey string) {
+	okKey, errKey = JSONConfig.OkKey, JSONConfig.ErrKey
+	if okKey == "" {
+		okKey = "ok"
+	}
+	if errKey == "" {
+		errKey = "err"
+	}
+
+	return okKey, errKey
+}
+
+// Ok returns a new instance of Result[T, error] that holds a value, where T is
+// the inherited type of value. c optionally configures the Result's
+// construction (see C.Strict); only the first element of c, if any, is used.
 //
 // Calling Match() on such a Result will always call an okF function.
-func Ok[T any](value T) Result[T, any] {
+func Ok[T any](value T, c ...C) Result[T, error] {
+	if len(c) > 0 {
+		return newResult[T, error](value, nil, c[0])
+	}
+
 	return ok[T](value)
 }
 
-// Err returns a new instance of Result[T, any] that holds an error, where T is
-// the inherited type of error.
+// Err returns a new instance of Result[T, error] that holds an error, where T
+// is the inherited type of value. c optionally configures the Result's
+// construction (see C.Strict); only the first element of c, if any, is used.
 //
 // Calling Match() on such a Result will always call an errF function.
-func Err[T any](rErr error) Result[T, any] {
+func Err[T any](rErr error, c ...C) Result[T, error] {
+	if len(c) > 0 {
+		var zero T
+
+		return newResult[T, error](zero, rErr, c[0])
+	}
+
 	return err[T](rErr)
 }
 
-// newResult returns an instance of the Result[T, any], where T is
-// the inherited type of value.
-func newResult[T any](value T, rError error) Result[T, any] {
-	return Result[T, any]{value: &value, err: rError}
+// OkE returns a new instance of Result[T, E] that holds a value, with an
+// explicit error type E. Use this instead of Ok when the error channel must
+// be a concrete domain error type rather than the stdlib error interface.
+// c optionally configures the Result's construction (see C.Strict); only the
+// first element of c, if any, is used.
+//
+// It is named OkE, not Ok[T, E], because Go cannot infer E from either of
+// Ok's arguments (E appears only in the return type), so a second type
+// parameter on Ok would force every existing `Ok(value)` call site to spell
+// out `Ok[int, error](value)`. OkE/ErrE keep Ok/Err's single-type-parameter
+// call sites working unchanged.
+func OkE[T, E any](value T, c ...C) Result[T, E] {
+	if len(c) > 0 {
+		var zeroErr E
+
+		return newResult(value, zeroErr, c[0])
+	}
+
+	return Result[T, E]{value: &value}
+}
+
+// ErrE returns a new instance of Result[T, E] that holds rErr as its typed
+// error value. See OkE for why it isn't named Err[T, E]. c optionally
+// configures the Result's construction (see C.Strict); only the first
+// element of c, if any, is used.
+func ErrE[T, E any](rErr E, c ...C) Result[T, E] {
+	if len(c) > 0 {
+		var zeroVal T
+
+		// Set hasErr directly: a value-typed E may equal its zero value and
+		// still be the error this Result holds.
+		return Result[T, E]{value: &zeroVal, err: rErr, hasErr: true, strict: c[0].Strict}
+	}
+
+	return Result[T, E]{err: rErr, hasErr: true}
+}
+
+// AsError converts a Result[T, E] into a Result[T, error] provided E satisfies
+// the error interface, so a typed-error pipeline can still flow into the
+// error-based helpers (Expect, ErrorIs, ErrorAs, Join, Collect...).
+func AsError[T any, E error](r Result[T, E]) Result[T, error] {
+	if r.isErr() {
+		return Err[T](r.innerError())
+	}
+
+	return Ok(r.innerValue())
+}
+
+// newResult returns an instance of the Result[T, E], where T is the inherited
+// type of value. c optionally configures the Result's construction; only the
+// first element, if any, is used.
+func newResult[T any, E any](value T, rError E, c ...C) Result[T, E] {
+	var cfg C
+	if len(c) > 0 {
+		cfg = c[0]
+	}
+
+	return Result[T, E]{value: &value, err: rError, hasErr: !isZeroValue(rError), strict: cfg.Strict}
+}
+
+func isZeroValue(v any) bool {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return true
+	}
+
+	return rv.IsZero()
 }
 
 // Match simulates pattern matching for handling scenarios of having a valid result
@@ -48,11 +164,11 @@ func newResult[T any](value T, rError error) Result[T, any] {
 //
 //	res := result.Match(userId,
 //		func(v int) int { return v * 2 },
-//		func(err int) int { return err * 0 },
+//		func(err error) int { return 0 },
 //	)
 //
 //	fmt.Println(res)
-func Match[T any, U any](r Result[T, any], okF mFunc[T, U], errF func(error) U) U {
+func Match[T, U, E any](r Result[T, E], okF mFunc[T, U], errF func(E) U) U {
 	if r.isErr() {
 		return errF(r.innerError())
 	}
@@ -60,7 +176,7 @@ func Match[T any, U any](r Result[T, any], okF mFunc[T, U], errF func(error) U)
 	return okF(r.innerValue())
 }
 
-func (r Result[T, any]) innerValue() T {
+func (r Result[T, E]) innerValue() T {
 	if r.value == nil {
 		return *new(T)
 	}
@@ -68,27 +184,23 @@ func (r Result[T, any]) innerValue() T {
 	return *r.value
 }
 
-func (r Result[T, any]) innerError() error {
-	if r.err == nil {
-		return nil
-	}
-
-	return r.err.(error)
+func (r Result[T, E]) innerError() E {
+	return r.err
 }
 
-func (r Result[T, any]) isErr() bool {
-	return r.err != nil
+func (r Result[T, E]) isErr() bool {
+	return r.hasErr
 }
 
-func (r Result[T, any]) isOk() bool {
-	return r.err == nil
+func (r Result[T, E]) isOk() bool {
+	return !r.hasErr
 }
 
 // And returns a passed newR if the Result has no error and newR has no error.
 //
 // Otherwise returns a Result with a contained error, if present,
 // or a new Result with error from newR.
-func (r Result[T, any]) And(newR Result[T, any]) Result[T, any] {
+func (r Result[T, E]) And(newR Result[T, E]) Result[T, E] {
 	if r.isErr() {
 		return r
 	}
@@ -104,7 +216,7 @@ func (r Result[T, any]) And(newR Result[T, any]) Result[T, any] {
 // Result has no error.
 //
 // Otherwise returns a Result with a contained error.
-func (r Result[T, any]) AndThen(f func(T) Result[T, any]) Result[T, any] {
+func (r Result[T, E]) AndThen(f func(T) Result[T, E]) Result[T, E] {
 	if r.isErr() {
 		return r
 	}
@@ -112,13 +224,32 @@ func (r Result[T, any]) AndThen(f func(T) Result[T, any]) Result[T, any] {
 	return f(r.innerValue())
 }
 
+// Or returns r if it has no error, otherwise returns other.
+func (r Result[T, E]) Or(other Result[T, E]) Result[T, E] {
+	if r.isOk() {
+		return r
+	}
+
+	return other
+}
+
+// OrElse returns r if it has no error, otherwise returns the result of f
+// function with the Result error as an argument.
+func (r Result[T, E]) OrElse(f func(E) Result[T, E]) Result[T, E] {
+	if r.isOk() {
+		return r
+	}
+
+	return f(r.innerError())
+}
+
 // IsErr returns true if Result has an error.
-func (r Result[T, any]) IsErr() bool {
+func (r Result[T, E]) IsErr() bool {
 	return r.isErr()
 }
 
 // IsErrAnd returns true if rErr matches the contained Result error.
-func (r Result[T, any]) IsErrAnd(f func(error) bool) bool {
+func (r Result[T, E]) IsErrAnd(f func(E) bool) bool {
 	if r.isOk() {
 		return false
 	}
@@ -127,13 +258,13 @@ func (r Result[T, any]) IsErrAnd(f func(error) bool) bool {
 }
 
 // IsOk returns true if Result has no error.
-func (r Result[T, any]) IsOk() bool {
+func (r Result[T, E]) IsOk() bool {
 	return r.isOk()
 }
 
 // IsOkAnd returns true if Result has no error and the contained value
 // matches a predicate of f.
-func (r Result[T, any]) IsOkAnd(f func(T) bool) bool {
+func (r Result[T, E]) IsOkAnd(f func(T) bool) bool {
 	if r.isErr() {
 		return false
 	}
@@ -143,29 +274,21 @@ func (r Result[T, any]) IsOkAnd(f func(T) bool) bool {
 
 // Map returns a new Result by applying an f function to a Result value,
 // leaving Result error untouched.
-func Map[T, U any](r Result[T, any], okF mFunc[T, U]) Result[U, any] {
-	computedValue := okF(r.innerValue())
-
-	return newResult(computedValue, r.innerError())
-}
-
-func Expand[U, T any](r Result[T, any]) Result[T, U] {
-	value := r.innerValue()
-
-	return Result[T, U]{value: &value, err: r.innerError()}
-}
-
-func (r Result[T, U]) Map(okF mFunc[T, U]) Result[U, any] {
+func Map[T, U, E any](r Result[T, E], okF mFunc[T, U]) Result[U, E] {
 	computedValue := okF(r.innerValue())
 
-	return Result[U, any]{value: &computedValue, err: r.innerError()}
+	// Carry r's error state through directly rather than re-deriving it via
+	// newResult's isZeroValue check: a value-typed E (e.g. an int-backed
+	// error code) can legitimately equal its zero value while still being an
+	// error, and reflection can't tell that apart from "no error".
+	return Result[U, E]{value: &computedValue, err: r.innerError(), hasErr: r.isErr(), strict: r.strict}
 }
 
 // MapErr returns result of errF function with Result error as an argument
 // if Result has an error.
 //
 // Otherwise returns self.
-func (r Result[T, any]) MapErr(errF func(error) error) Result[T, any] {
+func (r Result[T, E]) MapErr(errF func(E) E) Result[T, E] {
 	if r.isOk() {
 		return r
 	}
@@ -173,12 +296,12 @@ func (r Result[T, any]) MapErr(errF func(error) error) Result[T, any] {
 	computedErr := errF(r.innerError())
 	value := r.innerValue()
 
-	return Result[T, any]{value: &value, err: computedErr}
+	return Result[T, E]{value: &value, err: computedErr, hasErr: true}
 }
 
 // MapOr returns the provided rDefault of type T if Result has an error, otherwise
 // returns a result of f function with Result value as an argument.
-func (r Result[T, any]) MapOr(rDefault T, f func(T) T) T {
+func (r Result[T, E]) MapOr(rDefault T, f func(T) T) T {
 	if r.isErr() {
 		return rDefault
 	}
@@ -188,7 +311,7 @@ func (r Result[T, any]) MapOr(rDefault T, f func(T) T) T {
 
 // MapOrElse calls an errF function with Result error as an argument if Result
 // has an error, otherwise calls an okF function with a Result value as an argument.
-func (r Result[T, any]) MapOrElse(errF func(error) T, okF func(T) T) T {
+func (r Result[T, E]) MapOrElse(errF func(E) T, okF func(T) T) T {
 	if r.isErr() {
 		return errF(r.innerError())
 	}
@@ -196,7 +319,17 @@ func (r Result[T, any]) MapOrElse(errF func(error) T, okF func(T) T) T {
 	return okF(r.innerValue())
 }
 
-func (r Result[T, any]) Expect(msg string) Result[T, any] {
+// Expect wraps r's error with msg via fmt.Errorf's %w verb, leaving r
+// untouched if it holds no error. It is a free function, rather than a
+// method, because %w-wrapping needs a concrete error and Result's method set
+// must stay generic over E.
+//
+// BREAKING CHANGE: prior to the typed-error-channel redesign, Expect was a
+// method (r.Expect(msg)). Go cannot define a method specialized to one
+// instantiation of a generic receiver (there's no way to write "Expect only
+// exists when E is error" as a method), so every `r.Expect(msg)` call site
+// must become `result.Expect(r, msg)`.
+func Expect[T any](r Result[T, error], msg string) Result[T, error] {
 	if r.isOk() {
 		return r
 	}
@@ -204,20 +337,28 @@ func (r Result[T, any]) Expect(msg string) Result[T, any] {
 	wrappedErr := fmt.Errorf("%v: %w", msg, r.err)
 	value := r.innerValue()
 
-	return Result[T, any]{value: &value, err: wrappedErr}
+	return Result[T, error]{value: &value, err: wrappedErr, hasErr: true}
 }
 
 // Unwrap allows obtaining the nested value and error inside the Result as
-// a (T, error) return signature.
+// a (T, E) return signature.
 //
-// It is recommended to use the Match() method for proper pattern matching.
+// It is recommended to use the Match() function for proper pattern matching.
 //
-// Will panic if Result was built with C{strict: true}
-func (r Result[T, any]) Unwrap() (T, error) {
-	return r.innerValue(), r.innerError()
+// Will panic if Result was built via newResult with C{Strict: true} while
+// holding both a non-zero value and a non-zero error.
+func (r Result[T, E]) Unwrap() (T, E) {
+	value := r.innerValue()
+	rErr := r.innerError()
+
+	if r.strict && !isZeroValue(value) && !isZeroValue(rErr) {
+		panic(fmt.Sprintf("result: Unwrap called on a Result holding both value %v and error %v, built with C{Strict: true}", value, rErr))
+	}
+
+	return value, rErr
 }
 
-func (r Result[T, any]) UnwrapOr(rDefault T) T {
+func (r Result[T, E]) UnwrapOr(rDefault T) T {
 	if r.isOk() {
 		return r.innerValue()
 	}
@@ -225,7 +366,7 @@ func (r Result[T, any]) UnwrapOr(rDefault T) T {
 	return rDefault
 }
 
-func (r Result[T, any]) UnwrapOrElse(f func(error) T) T {
+func (r Result[T, E]) UnwrapOrElse(f func(E) T) T {
 	if r.isOk() {
 		return r.innerValue()
 	}
@@ -233,10 +374,375 @@ func (r Result[T, any]) UnwrapOrElse(f func(error) T) T {
 	return f(r.innerError())
 }
 
-func ok[T any](value T) Result[T, any] {
-	return Result[T, any]{value: &value}
+// Cause returns the error contained in the Result, or nil if the Result holds
+// no error.
+//
+// It exists alongside Unwrap() (which already occupies the (T, E)
+// signature) so that Result can still expose a plain error accessor compatible
+// with errors.Unwrap chains.
+func Cause[T any](r Result[T, error]) error {
+	return r.innerError()
+}
+
+// ErrorIs reports whether the Result's error, or any error in its wrapped
+// chain, matches target. It delegates to errors.Is and therefore understands
+// errors wrapped via Expect's %w formatting.
+func ErrorIs[T any](r Result[T, error], target error) bool {
+	return errors.Is(r.innerError(), target)
+}
+
+// ErrorAs finds the first error in the Result's wrapped chain that matches
+// target, and if so, sets target to that error value and returns true. It
+// delegates to errors.As.
+func ErrorAs[T any](r Result[T, error], target any) bool {
+	return errors.As(r.innerError(), target)
+}
+
+// IsErrTarget returns true if Result has an error and it matches target
+// according to errors.Is semantics. It is a convenience wrapper over IsErrAnd.
+func IsErrTarget[T any](r Result[T, error], target error) bool {
+	return r.IsErrAnd(func(err error) bool {
+		return errors.Is(err, target)
+	})
 }
 
-func err[T any](err error) Result[T, any] {
-	return Result[T, any]{err: err}
+func ok[T any](value T) Result[T, error] {
+	return Result[T, error]{value: &value}
+}
+
+func err[T any](rErr error) Result[T, error] {
+	return Result[T, error]{err: rErr, hasErr: true}
+}
+
+// joinError aggregates the errors of every failing Result passed to Join or
+// Collect, in the order they were encountered. Its Unwrap() []error method
+// makes it transparent to errors.Is and errors.As, matching the behavior of
+// the standard library's errors.Join.
+type joinError struct {
+	errs []error
+}
+
+func (e *joinError) Error() string {
+	msgs := make([]string, len(e.errs))
+	for i, rErr := range e.errs {
+		msgs[i] = rErr.Error()
+	}
+
+	return strings.Join(msgs, "\n")
+}
+
+func (e *joinError) Unwrap() []error {
+	return e.errs
+}
+
+// Join returns an Ok holding the values of every passed Result, or an Err
+// aggregating the errors of every failing Result, if any.
+func Join[T any](rs ...Result[T, error]) Result[[]T, error] {
+	return Collect(rs)
+}
+
+// Collect returns an Ok holding the values of every Result in rs, or an Err
+// aggregating the errors of every failing Result, if any.
+//
+// This is the Go equivalent of Rust's try_collect for a slice of independent
+// fallible operations, without hand-rolling the accumulation loop.
+func Collect[T any](rs []Result[T, error]) Result[[]T, error] {
+	values := make([]T, 0, len(rs))
+	var errs []error
+
+	for _, r := range rs {
+		if r.isErr() {
+			errs = append(errs, r.innerError())
+			continue
+		}
+
+		values = append(values, r.innerValue())
+	}
+
+	if len(errs) > 0 {
+		return Err[[]T](&joinError{errs: errs})
+	}
+
+	return Ok(values)
+}
+
+// tryError wraps the error of a Result that failed inside a Do block,
+// attaching the call stack captured at the point Try was invoked. Unwrap
+// exposes the original cause so errors.Is/errors.As still see through it.
+type tryError struct {
+	cause  error
+	frames []string
+}
+
+func (e *tryError) Error() string {
+	return fmt.Sprintf("%s\n%s", e.cause.Error(), strings.Join(e.frames, "\n"))
+}
+
+func (e *tryError) Unwrap() error {
+	return e.cause
+}
+
+// trySentinel is the value Try panics with. Do recovers only this type and
+// re-panics anything else, so the mechanism never leaks across package
+// boundaries or swallows unrelated panics.
+type trySentinel struct {
+	err *tryError
+}
+
+func captureFrames(skip int) []string {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(skip, pcs)
+	callerFrames := runtime.CallersFrames(pcs[:n])
+
+	frames := make([]string, 0, n)
+	for {
+		frame, more := callerFrames.Next()
+		frames = append(frames, fmt.Sprintf("%s\n\t%s:%d", frame.Function, frame.File, frame.Line))
+
+		if !more {
+			break
+		}
+	}
+
+	return frames
+}
+
+// Try returns r's value if r holds no error.
+//
+// If r holds an error, Try panics with an internal sentinel carrying the
+// error and the call stack at the point of failure. It is meant to be called
+// only inside a Do block, which recovers that sentinel and converts it back
+// into an Err Result, giving Go the ergonomics of Rust's ? operator:
+//
+//	result.Do(func() int {
+//		a := result.Try(fetchA())
+//		b := result.Try(fetchB(a))
+//		return a + b
+//	})
+func Try[T any](r Result[T, error]) T {
+	if r.IsErr() {
+		panic(trySentinel{err: &tryError{cause: r.innerError(), frames: captureFrames(3)}})
+	}
+
+	return r.innerValue()
+}
+
+// Do runs fn and returns its result as an Ok. If fn calls Try on an Err
+// Result, Do recovers the resulting panic and returns an Err instead of
+// letting the panic escape; its error wraps that Result's error (reachable via
+// errors.Is/errors.As) and its message also lists the call stack captured by
+// Try. Any other panic is re-raised untouched.
+func Do[T any](fn func() T) (out Result[T, error]) {
+	defer func() {
+		rec := recover()
+		if rec == nil {
+			return
+		}
+
+		sentinel, ok := rec.(trySentinel)
+		if !ok {
+			panic(rec)
+		}
+
+		out = Err[T](sentinel.err)
+	}()
+
+	return Ok(fn())
+}
+
+// String formats the Result as "Ok(v)" or "Err(e)", matching the style used
+// across the standard errors examples.
+func (r Result[T, E]) String() string {
+	if r.isErr() {
+		return fmt.Sprintf("Err(%v)", r.err)
+	}
+
+	return fmt.Sprintf("Ok(%v)", r.innerValue())
+}
+
+// GoString implements fmt.GoStringer, formatting the Result for %#v as
+// result.Ok[T](v) or result.Err[T](e).
+func (r Result[T, E]) GoString() string {
+	if r.isErr() {
+		return fmt.Sprintf("result.Err[%T](%#v)", r.innerValue(), r.err)
+	}
+
+	return fmt.Sprintf("result.Ok[%T](%#v)", r.innerValue(), r.innerValue())
+}
+
+// MarshalJSON implements json.Marshaler, encoding the Result as
+// {"ok": <value>} or {"err": "<message>"}. The object key names are taken
+// from JSONConfig.
+func (r Result[T, E]) MarshalJSON() ([]byte, error) {
+	okKey, errKey := jsonKeys()
+
+	if r.isErr() {
+		return json.Marshal(map[string]string{errKey: errMessage(r.err)})
+	}
+
+	return json.Marshal(map[string]T{okKey: r.innerValue()})
+}
+
+func errMessage(e any) string {
+	if err, ok := e.(error); ok {
+		return err.Error()
+	}
+
+	return fmt.Sprint(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding an object previously
+// produced by MarshalJSON back into a Result. Decoding an "err" key requires
+// E to accept an errors.New-style error value; for any other E it reports an
+// error instead of silently dropping the message.
+func (r *Result[T, E]) UnmarshalJSON(data []byte) error {
+	okKey, errKey := jsonKeys()
+
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return err
+	}
+
+	if raw, ok := envelope[errKey]; ok {
+		var msg string
+		if err := json.Unmarshal(raw, &msg); err != nil {
+			return err
+		}
+
+		rErr, ok := any(errors.New(msg)).(E)
+		if !ok {
+			return fmt.Errorf("result: cannot unmarshal error message into %T", *new(E))
+		}
+
+		*r = Result[T, E]{err: rErr, hasErr: true}
+
+		return nil
+	}
+
+	raw, ok := envelope[okKey]
+	if !ok {
+		return fmt.Errorf("result: JSON object has neither %q nor %q key", okKey, errKey)
+	}
+
+	var value T
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return err
+	}
+
+	*r = Result[T, E]{value: &value}
+
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rendering an Ok Result as
+// its value's text form. Marshaling an Err Result fails, since there is no
+// plain-text representation of the error channel outside of a message.
+func (r Result[T, E]) MarshalText() ([]byte, error) {
+	if r.isErr() {
+		return nil, fmt.Errorf("result: cannot marshal an Err Result to text: %v", r.err)
+	}
+
+	return []byte(fmt.Sprint(r.innerValue())), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, scanning text into an Ok
+// Result holding a value of type T. This lets a Result be used directly as
+// the target of an env-var or flag value.
+func (r *Result[T, E]) UnmarshalText(text []byte) error {
+	var value T
+	if _, err := fmt.Sscan(string(text), &value); err != nil {
+		return err
+	}
+
+	*r = Result[T, E]{value: &value}
+
+	return nil
+}
+
+// FromContext runs fn on its own goroutine and races it against ctx, short-
+// circuiting to Err(ctx.Err()) as soon as ctx is cancelled or times out even
+// if fn has not returned yet. ctx.Err() is wrapped with %w so
+// errors.Is(err, context.Canceled)/errors.Is(err, context.DeadlineExceeded)
+// keep working on the returned Result.
+//
+// fn keeps running in the background after a cancellation is reported; fn
+// itself must observe ctx (e.g. via ctx.Done()) to actually stop early.
+func FromContext[T any](ctx context.Context, fn func(context.Context) (T, error)) Result[T, error] {
+	if err := ctx.Err(); err != nil {
+		return Err[T](fmt.Errorf("result: context done: %w", err))
+	}
+
+	type outcome struct {
+		value T
+		err   error
+	}
+
+	done := make(chan outcome, 1)
+	go func() {
+		value, err := fn(ctx)
+		done <- outcome{value: value, err: err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return Err[T](fmt.Errorf("result: context done: %w", ctx.Err()))
+	case out := <-done:
+		if out.err != nil {
+			return Err[T](out.err)
+		}
+
+		return Ok(out.value)
+	}
+}
+
+// AndThenCtx returns the result of f with ctx and r's value as arguments, if r
+// has no error. It races f against ctx the same way FromContext races fn,
+// short-circuiting to Err(ctx.Err()) as soon as ctx is cancelled or times out
+// even if f has not returned yet.
+//
+// f keeps running in the background after a cancellation is reported; f
+// itself must observe ctx (e.g. via ctx.Done()) to actually stop early.
+//
+// It is a free function, like Expect, because propagating ctx.Err() needs a
+// concrete error and Result's method set must stay generic over E.
+func AndThenCtx[T any](ctx context.Context, r Result[T, error], f func(context.Context, T) Result[T, error]) Result[T, error] {
+	if r.IsErr() {
+		return r
+	}
+
+	if err := ctx.Err(); err != nil {
+		return Err[T](fmt.Errorf("result: context done: %w", err))
+	}
+
+	done := make(chan Result[T, error], 1)
+	go func() {
+		done <- f(ctx, r.innerValue())
+	}()
+
+	select {
+	case <-ctx.Done():
+		return Err[T](fmt.Errorf("result: context done: %w", ctx.Err()))
+	case out := <-done:
+		return out
+	}
+}
+
+// WithDeadline runs fn through FromContext under a context bound by deadline,
+// short-circuiting to Err(context.DeadlineExceeded) as soon as the deadline
+// passes, even if fn has not returned yet.
+func WithDeadline[T any](ctx context.Context, deadline time.Time, fn func(context.Context) (T, error)) Result[T, error] {
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	return FromContext(ctx, fn)
+}
+
+// WithTimeout runs fn through FromContext under a context bound by timeout,
+// short-circuiting to Err(context.DeadlineExceeded) as soon as the timeout
+// elapses, even if fn has not returned yet.
+func WithTimeout[T any](ctx context.Context, timeout time.Duration, fn func(context.Context) (T, error)) Result[T, error] {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return FromContext(ctx, fn)
 }
@@ -1,7 +1,11 @@
 package result
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -71,3 +75,288 @@ func TestNew(t *testing.T) {
 		})
 	}
 }
+
+var errSentinel = errors.New("sentinel")
+
+func TestErrorChainThroughExpect(t *testing.T) {
+	tests := []struct {
+		name   string
+		result Result[int, error]
+		target error
+		wantIs bool
+	}{
+		{
+			name:   "wrapped sentinel matches errors.Is",
+			result: Expect(Err[int](errSentinel), "doing the thing"),
+			target: errSentinel,
+			wantIs: true,
+		},
+		{
+			name:   "unrelated error does not match",
+			result: Expect(Err[int](errors.New("other")), "doing the thing"),
+			target: errSentinel,
+			wantIs: false,
+		},
+		{
+			name:   "Ok result never matches",
+			result: Expect(Ok(1), "doing the thing"),
+			target: errSentinel,
+			wantIs: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantIs, ErrorIs(tt.result, tt.target))
+			assert.Equal(t, tt.wantIs, IsErrTarget(tt.result, tt.target))
+		})
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		result Result[int, error]
+		want   string
+	}{
+		{
+			name:   "Ok value",
+			result: Ok(42),
+			want:   `{"ok":42}`,
+		},
+		{
+			name:   "Err value",
+			result: Err[int](errSentinel),
+			want:   `{"err":"sentinel"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.result)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+
+			var roundTripped Result[int, error]
+			assert.NoError(t, json.Unmarshal(got, &roundTripped))
+			assert.Equal(t, tt.result.IsErr(), roundTripped.IsErr())
+
+			if !tt.result.IsErr() {
+				value, _ := roundTripped.Unwrap()
+				wantValue, _ := tt.result.Unwrap()
+				assert.Equal(t, wantValue, value)
+			}
+		})
+	}
+}
+
+func TestTryDo(t *testing.T) {
+	tests := []struct {
+		name    string
+		fn      func() int
+		wantVal int
+		wantErr bool
+	}{
+		{
+			name: "all Try calls succeed",
+			fn: func() int {
+				a := Try(Ok(1))
+				b := Try(Ok(2))
+
+				return a + b
+			},
+			wantVal: 3,
+		},
+		{
+			name: "a failing Try short-circuits to Err",
+			fn: func() int {
+				a := Try(Ok(1))
+				b := Try(Err[int](errSentinel))
+
+				return a + b
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Do(tt.fn)
+
+			assert.Equal(t, tt.wantErr, got.IsErr())
+			if !tt.wantErr {
+				value, _ := got.Unwrap()
+				assert.Equal(t, tt.wantVal, value)
+			} else {
+				assert.True(t, ErrorIs(got, errSentinel))
+			}
+		})
+	}
+}
+
+func TestDoRepanicsUnrelatedPanics(t *testing.T) {
+	assert.PanicsWithValue(t, "boom", func() {
+		Do(func() int {
+			panic("boom")
+		})
+	})
+}
+
+func TestFromContextShortCircuitsOnTimeout(t *testing.T) {
+	r := WithTimeout(context.Background(), 1*time.Millisecond, func(ctx context.Context) (int, error) {
+		time.Sleep(20 * time.Millisecond)
+
+		return 99, nil
+	})
+
+	assert.True(t, r.IsErr())
+	_, err := r.Unwrap()
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestFromContextShortCircuitsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	r := FromContext(ctx, func(ctx context.Context) (int, error) {
+		return 99, nil
+	})
+
+	assert.True(t, r.IsErr())
+	_, err := r.Unwrap()
+	assert.True(t, errors.Is(err, context.Canceled))
+}
+
+func TestFromContextSuccessPath(t *testing.T) {
+	r := WithTimeout(context.Background(), 50*time.Millisecond, func(ctx context.Context) (int, error) {
+		return 99, nil
+	})
+
+	assert.False(t, r.IsErr())
+	value, err := r.Unwrap()
+	assert.NoError(t, err)
+	assert.Equal(t, 99, value)
+}
+
+func TestWithDeadlineShortCircuits(t *testing.T) {
+	r := WithDeadline(context.Background(), time.Now().Add(1*time.Millisecond), func(ctx context.Context) (int, error) {
+		time.Sleep(20 * time.Millisecond)
+
+		return 99, nil
+	})
+
+	assert.True(t, r.IsErr())
+	_, err := r.Unwrap()
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestAndThenCtxShortCircuitsOnTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Millisecond)
+	defer cancel()
+
+	r := AndThenCtx(ctx, Ok(1), func(ctx context.Context, v int) Result[int, error] {
+		time.Sleep(20 * time.Millisecond)
+
+		return Ok(v + 1)
+	})
+
+	assert.True(t, r.IsErr())
+	_, err := r.Unwrap()
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestAndThenCtxPropagatesSourceError(t *testing.T) {
+	r := AndThenCtx(context.Background(), Err[int](errSentinel), func(ctx context.Context, v int) Result[int, error] {
+		return Ok(v + 1)
+	})
+
+	assert.True(t, ErrorIs(r, errSentinel))
+}
+
+var errSentinel2 = errors.New("sentinel2")
+
+func TestCollectAllOk(t *testing.T) {
+	got := Collect([]Result[int, error]{Ok(1), Ok(2), Ok(3)})
+
+	assert.False(t, got.IsErr())
+	value, err := got.Unwrap()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, value)
+}
+
+func TestCollectAggregatesErrorsInOrder(t *testing.T) {
+	got := Collect([]Result[int, error]{
+		Ok(1),
+		Err[int](errSentinel),
+		Ok(2),
+		Err[int](errSentinel2),
+	})
+
+	assert.True(t, got.IsErr())
+
+	_, err := got.Unwrap()
+	assert.True(t, errors.Is(err, errSentinel))
+	assert.True(t, errors.Is(err, errSentinel2))
+
+	var joined interface{ Unwrap() []error }
+	assert.True(t, errors.As(err, &joined))
+	assert.Equal(t, []error{errSentinel, errSentinel2}, joined.Unwrap())
+}
+
+func TestJoinDelegatesToCollect(t *testing.T) {
+	got := Join(Ok(1), Err[int](errSentinel))
+
+	assert.True(t, got.IsErr())
+	_, err := got.Unwrap()
+	assert.True(t, errors.Is(err, errSentinel))
+}
+
+type domainError struct {
+	code int
+}
+
+func (e domainError) Error() string {
+	return "domain error"
+}
+
+func TestTypedErrorChannel(t *testing.T) {
+	ok := OkE[int, domainError](42)
+	assert.False(t, ok.IsErr())
+
+	value, dErr := ok.Unwrap()
+	assert.Equal(t, 42, value)
+	assert.Equal(t, domainError{}, dErr)
+
+	fail := ErrE[int, domainError](domainError{code: 7})
+	assert.True(t, fail.IsErr())
+
+	_, dErr = fail.Unwrap()
+	assert.Equal(t, domainError{code: 7}, dErr)
+
+	matched := Match(fail,
+		func(v int) string { return "ok" },
+		func(e domainError) string { return e.Error() },
+	)
+	assert.Equal(t, "domain error", matched)
+
+	mapped := fail.MapErr(func(e domainError) domainError {
+		e.code *= 2
+		return e
+	})
+	_, dErr = mapped.Unwrap()
+	assert.Equal(t, domainError{code: 14}, dErr)
+}
+
+func TestAsErrorBridgesToErrorChannel(t *testing.T) {
+	okTyped := OkE[int, domainError](1)
+	bridged := AsError(okTyped)
+	assert.False(t, bridged.IsErr())
+	value, err := bridged.Unwrap()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, value)
+
+	failTyped := ErrE[int, domainError](domainError{code: 9})
+	bridgedFail := AsError(failTyped)
+	assert.True(t, bridgedFail.IsErr())
+	assert.True(t, ErrorIs(bridgedFail, domainError{code: 9}))
+}